@@ -3,6 +3,7 @@
 import (
 	"context"
 	"math/rand"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -23,6 +24,22 @@ type commandContentVerify struct {
 	progressInterval            time.Duration
 
 	contentRange contentRangeFlags
+	jo           jsonOutput
+	out          textOutput
+}
+
+// ContentVerifyResult is used to display the results of content verification in JSON format.
+type ContentVerifyResult struct {
+	VerifiedCount int                  `json:"verifiedCount"`
+	ErrorCount    int                  `json:"errorCount"`
+	Errors        []ContentVerifyError `json:"errors"`
+}
+
+// ContentVerifyError describes a single content that failed verification.
+type ContentVerifyError struct {
+	ContentID  content.ID `json:"contentID"`
+	PackBlobID blob.ID    `json:"packBlobID"`
+	Error      string     `json:"error"`
 }
 
 func (c *commandContentVerify) setup(svc appServices, parent commandParent) {
@@ -34,6 +51,8 @@ func (c *commandContentVerify) setup(svc appServices, parent commandParent) {
 	cmd.Flag("download-percent", "Download a percentage of files [0.0 .. 100.0]").Float64Var(&c.contentVerifyPercent)
 	cmd.Flag("progress-interval", "Progress output interval").Default("3s").DurationVar(&c.progressInterval)
 	c.contentRange.setup(cmd)
+	c.jo.setup(svc, cmd)
+	c.out.setup(svc)
 	cmd.Action(svc.directRepositoryReadAction(c.run))
 }
 
@@ -55,6 +74,9 @@ func (c *commandContentVerify) run(ctx context.Context, rep repo.DirectRepositor
 		successCount  atomic.Int32
 		errorCount    atomic.Int32
 		totalCount    atomic.Int32
+
+		errorsMutex sync.Mutex
+		errorList   = []ContentVerifyError{}
 	)
 
 	subctx, cancel := context.WithCancel(ctx)
@@ -90,6 +112,16 @@ func (c *commandContentVerify) run(ctx context.Context, rep repo.DirectRepositor
 		if err := c.contentVerify(ctx, rep.ContentReader(), ci, blobMap, downloadPercent); err != nil {
 			log(ctx).Errorf("error %v", err)
 			errorCount.Add(1)
+
+			if c.jo.jsonOutput {
+				errorsMutex.Lock()
+				errorList = append(errorList, ContentVerifyError{
+					ContentID:  ci.GetContentID(),
+					PackBlobID: ci.GetPackBlobID(),
+					Error:      err.Error(),
+				})
+				errorsMutex.Unlock()
+			}
 		} else {
 			successCount.Add(1)
 		}
@@ -120,6 +152,20 @@ func (c *commandContentVerify) run(ctx context.Context, rep repo.DirectRepositor
 	log(ctx).Infof("Finished verifying %v contents, found %v errors.", verifiedCount.Load(), errorCount.Load())
 
 	ec := errorCount.Load()
+
+	if c.jo.jsonOutput {
+		// parallel verification reports errors in random order.
+		sort.Slice(errorList, func(i, j int) bool {
+			return errorList[i].ContentID.String() < errorList[j].ContentID.String()
+		})
+
+		c.out.printStdout("%s\n", c.jo.jsonBytes(ContentVerifyResult{
+			VerifiedCount: int(verifiedCount.Load()),
+			ErrorCount:    int(ec),
+			Errors:        errorList,
+		}))
+	}
+
 	if ec == 0 {
 		return nil
 	}
@@ -9,6 +9,7 @@
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/kopia/kopia/cli"
 	"github.com/kopia/kopia/internal/testutil"
 	"github.com/kopia/kopia/tests/testenv"
 )
@@ -24,6 +25,14 @@ func (s *formatSpecificTestSuite) TestContentVerify(t *testing.T) {
 	env.RunAndExpectSuccess(t, "snapshot", "create", dir)
 	env.RunAndExpectSuccess(t, "content", "verify", "--download-percent=30")
 
+	var result cli.ContentVerifyResult
+
+	testutil.MustParseJSONLines(t, env.RunAndExpectSuccess(t, "content", "verify", "--json"), &result)
+	require.NotZero(t, result.VerifiedCount)
+	require.Zero(t, result.ErrorCount)
+	require.NotNil(t, result.Errors)
+	require.Empty(t, result.Errors)
+
 	// delete one of 'p' blobs.
 	blobIDToDelete := strings.Split(env.RunAndExpectSuccess(t, "blob", "list", "--prefix=p")[0], " ")[0]
 	blobList := env.RunAndExpectSuccess(t, "blob", "list")
@@ -37,4 +46,15 @@ func (s *formatSpecificTestSuite) TestContentVerify(t *testing.T) {
 	mustGetLineContaining(t, verifyStderr, "missing blob "+blobIDToDelete)
 
 	env.RunAndExpectFailure(t, "content", "verify", "--full")
+
+	verifyStdout, _, err := env.Run(t, true, "content", "verify", "--json")
+	require.Error(t, err)
+
+	testutil.MustParseJSONLines(t, verifyStdout, &result)
+	require.NotZero(t, result.ErrorCount)
+	require.Len(t, result.Errors, result.ErrorCount)
+
+	for _, e := range result.Errors {
+		require.Equal(t, blobIDToDelete, string(e.PackBlobID))
+	}
 }
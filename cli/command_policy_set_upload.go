@@ -12,12 +12,16 @@ type policyUploadFlags struct {
 	maxParallelUploads            string
 	maxParallelFileReads          string
 	parallelizeUploadAboveSizeMiB string
+	detectModifiedFiles           string
+	modifiedFileRetries           string
 }
 
 func (c *policyUploadFlags) setup(cmd *kingpin.CmdClause) {
 	cmd.Flag("max-parallel-file-reads", "Maximum number of parallel file reads").StringVar(&c.maxParallelFileReads)
 	cmd.Flag("max-parallel-snapshots", "Maximum number of parallel snapshots (server, KopiaUI only)").StringVar(&c.maxParallelUploads)
 	cmd.Flag("parallel-upload-above-size-mib", "Use parallel uploads above size").StringVar(&c.parallelizeUploadAboveSizeMiB)
+	cmd.Flag("detect-modified-files", "Detect files modified while being snapshotted ('true', 'false', 'inherit')").EnumVar(&c.detectModifiedFiles, booleanEnumValues...)
+	cmd.Flag("modified-file-retries", "Number of times to retry snapshotting a file that was modified while being read").StringVar(&c.modifiedFileRetries)
 }
 
 func (c *policyUploadFlags) setUploadPolicyFromFlags(ctx context.Context, up *policy.UploadPolicy, changeCount *int) error {
@@ -29,5 +33,13 @@ func (c *policyUploadFlags) setUploadPolicyFromFlags(ctx context.Context, up *po
 		return err
 	}
 
-	return applyOptionalInt64MiB(ctx, "parallel upload above size", &up.ParallelUploadAboveSize, c.parallelizeUploadAboveSizeMiB, changeCount)
+	if err := applyOptionalInt64MiB(ctx, "parallel upload above size", &up.ParallelUploadAboveSize, c.parallelizeUploadAboveSizeMiB, changeCount); err != nil {
+		return err
+	}
+
+	if err := applyPolicyBoolPtr(ctx, "detect modified files", &up.DetectModifiedFiles, c.detectModifiedFiles, changeCount); err != nil {
+		return err
+	}
+
+	return applyOptionalInt(ctx, "modified file retries", &up.ModifiedFileRetries, c.modifiedFileRetries, changeCount)
 }
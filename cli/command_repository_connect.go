@@ -57,6 +57,7 @@ type connectOptions struct {
 	connectPermissiveCacheLoading bool
 	connectDescription            string
 	connectEnableActions          bool
+	connectParallelFetches        int
 
 	formatBlobCacheDuration time.Duration
 	disableFormatBlobCache  bool
@@ -76,6 +77,7 @@ func (c *connectOptions) setup(svc appServices, cmd *kingpin.CmdClause) {
 	cmd.Flag("permissive-cache-loading", "Do not fail when loading bad cache index entries.  Repository must be opened in read-only mode").Hidden().BoolVar(&c.connectPermissiveCacheLoading)
 	cmd.Flag("description", "Human-readable description of the repository").StringVar(&c.connectDescription)
 	cmd.Flag("enable-actions", "Allow snapshot actions").BoolVar(&c.connectEnableActions)
+	cmd.Flag("parallel-fetches", "Number of parallel reads when loading indexes").Hidden().IntVar(&c.connectParallelFetches)
 	cmd.Flag("repository-format-cache-duration", "Duration of kopia.repository format blob cache").Hidden().DurationVar(&c.formatBlobCacheDuration)
 	cmd.Flag("disable-repository-format-cache", "Disable caching of kopia.repository format blob").Hidden().BoolVar(&c.disableFormatBlobCache)
 }
@@ -104,6 +106,7 @@ func (c *connectOptions) toRepoConnectOptions() *repo.ConnectOptions {
 			Description:             c.connectDescription,
 			EnableActions:           c.connectEnableActions,
 			FormatBlobCacheDuration: c.getFormatBlobCacheDuration(),
+			ParallelFetches:         c.connectParallelFetches,
 		},
 	}
 }
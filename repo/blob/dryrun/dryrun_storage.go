@@ -0,0 +1,114 @@
+// Package dryrun implements wrapper around blob.Storage that counts all mutations without performing them.
+package dryrun
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/kopia/kopia/internal/clock"
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/kopia/kopia/repo/logging"
+)
+
+var log = logging.Module("dryrun")
+
+// Stats keeps track of mutations that were skipped by the dry-run storage.
+type Stats struct {
+	PutBlobCount         atomic.Int64
+	PutBlobBytes         atomic.Int64
+	DeleteBlobCount      atomic.Int64
+	ExtendRetentionCount atomic.Int64
+}
+
+// dryRunStorage passes all reads to the underlying storage while skipping all mutations.
+type dryRunStorage struct {
+	base  blob.Storage
+	stats *Stats
+}
+
+func (s *dryRunStorage) GetCapacity(ctx context.Context) (blob.Capacity, error) {
+	//nolint:wrapcheck
+	return s.base.GetCapacity(ctx)
+}
+
+func (s *dryRunStorage) GetBlob(ctx context.Context, id blob.ID, offset, length int64, output blob.OutputBuffer) error {
+	//nolint:wrapcheck
+	return s.base.GetBlob(ctx, id, offset, length, output)
+}
+
+func (s *dryRunStorage) GetMetadata(ctx context.Context, id blob.ID) (blob.Metadata, error) {
+	//nolint:wrapcheck
+	return s.base.GetMetadata(ctx, id)
+}
+
+func (s *dryRunStorage) PutBlob(ctx context.Context, id blob.ID, data blob.Bytes, opts blob.PutOptions) error {
+	if opts.DoNotRecreate {
+		_, err := s.base.GetMetadata(ctx, id)
+
+		switch {
+		case err == nil:
+			return blob.ErrBlobAlreadyExists
+		case !errors.Is(err, blob.ErrBlobNotFound):
+			return errors.Wrap(err, "unable to check if blob exists")
+		}
+	}
+
+	log(ctx).Debugf("skipping PutBlob(%v) of %v bytes", id, data.Length())
+
+	s.stats.PutBlobCount.Add(1)
+	s.stats.PutBlobBytes.Add(int64(data.Length()))
+
+	if opts.GetModTime != nil {
+		*opts.GetModTime = clock.Now()
+	}
+
+	return nil
+}
+
+func (s *dryRunStorage) DeleteBlob(ctx context.Context, id blob.ID) error {
+	log(ctx).Debugf("skipping DeleteBlob(%v)", id)
+
+	s.stats.DeleteBlobCount.Add(1)
+
+	return nil
+}
+
+//nolint:revive
+func (s *dryRunStorage) ExtendBlobRetention(ctx context.Context, id blob.ID, opts blob.ExtendOptions) error {
+	log(ctx).Debugf("skipping ExtendBlobRetention(%v)", id)
+
+	s.stats.ExtendRetentionCount.Add(1)
+
+	return nil
+}
+
+func (s *dryRunStorage) ListBlobs(ctx context.Context, prefix blob.ID, callback func(blob.Metadata) error) error {
+	//nolint:wrapcheck
+	return s.base.ListBlobs(ctx, prefix, callback)
+}
+
+func (s *dryRunStorage) Close(ctx context.Context) error {
+	//nolint:wrapcheck
+	return s.base.Close(ctx)
+}
+
+func (s *dryRunStorage) ConnectionInfo() blob.ConnectionInfo {
+	return s.base.ConnectionInfo()
+}
+
+func (s *dryRunStorage) DisplayName() string {
+	return s.base.DisplayName()
+}
+
+func (s *dryRunStorage) FlushCaches(ctx context.Context) error {
+	//nolint:wrapcheck
+	return s.base.FlushCaches(ctx)
+}
+
+// NewWrapper returns a Storage wrapper that passes reads to the underlying storage and records
+// all mutations in the provided Stats without executing them.
+func NewWrapper(wrapped blob.Storage, stats *Stats) blob.Storage {
+	return &dryRunStorage{base: wrapped, stats: stats}
+}
@@ -0,0 +1,57 @@
+package dryrun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kopia/kopia/internal/blobtesting"
+	"github.com/kopia/kopia/internal/gather"
+	"github.com/kopia/kopia/internal/testlogging"
+	"github.com/kopia/kopia/repo/blob"
+)
+
+func TestDryRunStorage(t *testing.T) {
+	ctx := testlogging.Context(t)
+
+	data := blobtesting.DataMap{}
+	kt := map[blob.ID]time.Time{}
+	underlying := blobtesting.NewMapStorage(data, kt, nil)
+
+	require.NoError(t, underlying.PutBlob(ctx, "existing", gather.FromSlice([]byte{1, 2, 3}), blob.PutOptions{}))
+
+	var stats Stats
+
+	st := NewWrapper(underlying, &stats)
+
+	// reads are passed through
+	var tmp gather.WriteBuffer
+	defer tmp.Close()
+
+	require.NoError(t, st.GetBlob(ctx, "existing", 0, -1, &tmp))
+	require.Equal(t, []byte{1, 2, 3}, tmp.ToByteSlice())
+
+	// mutations are counted, but not performed
+	require.NoError(t, st.PutBlob(ctx, "new", gather.FromSlice([]byte{4, 5, 6, 7}), blob.PutOptions{}))
+	require.NoError(t, st.DeleteBlob(ctx, "existing"))
+	require.NoError(t, st.ExtendBlobRetention(ctx, "existing", blob.ExtendOptions{}))
+
+	blobtesting.AssertGetBlobNotFound(ctx, t, underlying, "new")
+	require.Contains(t, data, blob.ID("existing"))
+
+	// modification time is reported for skipped writes.
+	var modTime time.Time
+
+	require.NoError(t, st.PutBlob(ctx, "new2", gather.FromSlice([]byte{8}), blob.PutOptions{GetModTime: &modTime}))
+	require.False(t, modTime.IsZero())
+
+	// DoNotRecreate is honored based on the contents of the underlying storage.
+	require.ErrorIs(t, st.PutBlob(ctx, "existing", gather.FromSlice([]byte{9}), blob.PutOptions{DoNotRecreate: true}), blob.ErrBlobAlreadyExists)
+	require.NoError(t, st.PutBlob(ctx, "new3", gather.FromSlice([]byte{10}), blob.PutOptions{DoNotRecreate: true}))
+
+	require.EqualValues(t, 3, stats.PutBlobCount.Load())
+	require.EqualValues(t, 6, stats.PutBlobBytes.Load())
+	require.EqualValues(t, 1, stats.DeleteBlobCount.Load())
+	require.EqualValues(t, 1, stats.ExtendRetentionCount.Load())
+}
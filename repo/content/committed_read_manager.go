@@ -90,6 +90,15 @@ type SharedManager struct {
 	indexesLock            sync.RWMutex
 	permissiveCacheLoading bool
 
+	// number of pending index entries after which writers will automatically flush, 0 == unlimited.
+	maxPendingIndexEntries int
+
+	// number of goroutines used to fetch index blobs and prefetch contents.
+	parallelFetches int
+
+	// time after which writers automatically flush pending writes.
+	flushTimeout time.Duration
+
 	// maybeRefreshIndexes() will call Refresh() after this point in ime.
 	// +checklocks:indexesLock
 	refreshIndexesAfter time.Time
@@ -229,7 +238,7 @@ func (sm *SharedManager) loadPackIndexesLocked(ctx context.Context) error {
 			indexBlobIDs = append(indexBlobIDs, b.BlobID)
 		}
 
-		err = sm.committedContents.fetchIndexBlobs(ctx, sm.permissiveCacheLoading, indexBlobIDs)
+		err = sm.committedContents.fetchIndexBlobs(ctx, sm.permissiveCacheLoading, sm.parallelFetches, indexBlobIDs)
 		if err == nil {
 			err = sm.committedContents.use(ctx, indexBlobIDs, ignoreDeletedBefore)
 			if err != nil {
@@ -603,6 +612,9 @@ func NewSharedManager(ctx context.Context, st blob.Storage, prov format.Provider
 		timeNow:                 opts.TimeNow,
 		format:                  prov,
 		permissiveCacheLoading:  opts.PermissiveCacheLoading,
+		maxPendingIndexEntries:  opts.MaxPendingIndexEntries,
+		parallelFetches:         defaultParallelFetches,
+		flushTimeout:            defaultFlushTimeout,
 		minPreambleLength:       defaultMinPreambleLength,
 		maxPreambleLength:       defaultMaxPreambleLength,
 		paddingUnit:             defaultPaddingUnit,
@@ -613,6 +625,14 @@ func NewSharedManager(ctx context.Context, st blob.Storage, prov format.Provider
 		metricsStruct: initMetricsStruct(mr),
 	}
 
+	if opts.ParallelFetches > 0 {
+		sm.parallelFetches = opts.ParallelFetches
+	}
+
+	if opts.FlushTimeout > 0 {
+		sm.flushTimeout = opts.FlushTimeout
+	}
+
 	if !opts.DisableInternalLog {
 		sm.internalLogger = sm.repoLogManager.NewLogger()
 	}
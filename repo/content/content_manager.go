@@ -51,8 +51,8 @@
 }
 
 const (
-	parallelFetches          = 5                // number of parallel reads goroutines
-	flushPackIndexTimeout    = 10 * time.Minute // time after which all pending indexes are flushes
+	defaultParallelFetches   = 5                // number of parallel reads goroutines
+	defaultFlushTimeout      = 10 * time.Minute // time after which all pending indexes are flushes
 	defaultMinPreambleLength = 32
 	defaultMaxPreambleLength = 32
 	defaultPaddingUnit       = 4096
@@ -215,9 +215,9 @@ func (d *deletedInfo) GetTimestampSeconds() int64 {
 	return d.deletedTime
 }
 
-func (bm *WriteManager) maybeFlushBasedOnTimeUnlocked(ctx context.Context) error {
+func (bm *WriteManager) maybeFlushBasedOnTimeOrEntryCountUnlocked(ctx context.Context) error {
 	bm.lock()
-	shouldFlush := bm.timeNow().After(bm.flushPackIndexesAfter)
+	shouldFlush := bm.timeNow().After(bm.flushPackIndexesAfter) || bm.tooManyPendingIndexEntriesLocked()
 	bm.unlock()
 
 	if !shouldFlush {
@@ -227,6 +227,15 @@ func (bm *WriteManager) maybeFlushBasedOnTimeUnlocked(ctx context.Context) error
 	return bm.Flush(ctx)
 }
 
+// +checklocks:bm.mu
+func (bm *WriteManager) tooManyPendingIndexEntriesLocked() bool {
+	if bm.maxPendingIndexEntries <= 0 {
+		return false
+	}
+
+	return len(bm.packIndexBuilder) >= bm.maxPendingIndexEntries
+}
+
 func (bm *WriteManager) maybeRetryWritingFailedPacksUnlocked(ctx context.Context) error {
 	bm.lock()
 	defer bm.unlock()
@@ -255,8 +264,8 @@ func (bm *WriteManager) maybeRetryWritingFailedPacksUnlocked(ctx context.Context
 }
 
 func (bm *WriteManager) addToPackUnlocked(ctx context.Context, contentID ID, data gather.Bytes, isDeleted bool, comp compression.HeaderID, previousWriteTime int64, mp format.MutableParameters) error {
-	// see if the current index is old enough to cause automatic flush.
-	if err := bm.maybeFlushBasedOnTimeUnlocked(ctx); err != nil {
+	// see if the current index is old or large enough to cause automatic flush.
+	if err := bm.maybeFlushBasedOnTimeOrEntryCountUnlocked(ctx); err != nil {
 		return errors.Wrap(err, "unable to flush old pending writes")
 	}
 
@@ -490,7 +499,7 @@ func (bm *WriteManager) flushPackIndexesLocked(ctx context.Context, mp format.Mu
 		bm.packIndexBuilder = make(index.Builder)
 	}
 
-	bm.flushPackIndexesAfter = bm.timeNow().Add(flushPackIndexTimeout)
+	bm.flushPackIndexesAfter = bm.timeNow().Add(bm.flushTimeout)
 
 	return nil
 }
@@ -952,6 +961,9 @@ type ManagerOptions struct {
 	RetentionMode          string
 	RetentionPeriod        time.Duration
 	PermissiveCacheLoading bool
+	MaxPendingIndexEntries int           // automatically flush when at least this many index entries are pending, 0 == unlimited
+	ParallelFetches        int           // number of parallel blob reads when loading indexes or prefetching, 0 == default
+	FlushTimeout           time.Duration // time after which pending writes are automatically flushed, 0 == default
 }
 
 // CloneOrDefault returns a clone of provided ManagerOptions or default empty struct if nil.
@@ -996,7 +1008,7 @@ func NewWriteManager(ctx context.Context, sm *SharedManager, options SessionOpti
 	wm := &WriteManager{
 		SharedManager: sm,
 
-		flushPackIndexesAfter: sm.timeNow().Add(flushPackIndexTimeout),
+		flushPackIndexesAfter: sm.timeNow().Add(sm.flushTimeout),
 		pendingPacks:          map[blob.ID]*pendingPackInfo{},
 		packIndexBuilder:      make(index.Builder),
 		sessionUser:           options.SessionUser,
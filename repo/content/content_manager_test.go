@@ -292,6 +292,131 @@ func (s *contentManagerSuite) TestContentManagerInternalFlush(t *testing.T) {
 	}
 }
 
+func (s *contentManagerSuite) TestContentManagerFlushOnTooManyPendingIndexEntries(t *testing.T) {
+	ctx := testlogging.Context(t)
+	data := blobtesting.DataMap{}
+	st := blobtesting.NewMapStorage(data, nil, nil)
+	bm := s.newTestContentManagerWithTweaks(t, st, &contentManagerTestTweaks{
+		ManagerOptions: ManagerOptions{
+			MaxPendingIndexEntries: 10,
+		},
+	})
+
+	// write until the first pack gets written, its entries are now pending in the index builder.
+	for i := 0; len(data) < 2; i++ {
+		writeContentAndVerify(ctx, t, bm, seededRandomData(i+100, 25))
+	}
+
+	verifyBlobCount(t, data, map[blob.ID]int{"s": 1, "p": 1})
+	verifyActiveIndexBlobCount(ctx, t, bm, 0)
+
+	// next write exceeds the limit and triggers automatic flush.
+	writeContentAndVerify(ctx, t, bm, seededRandomData(1, 25))
+	verifyActiveIndexBlobCount(ctx, t, bm, 1)
+}
+
+func (s *contentManagerSuite) TestContentManagerFlushTimeout(t *testing.T) {
+	ctx := testlogging.Context(t)
+	data := blobtesting.DataMap{}
+	st := blobtesting.NewMapStorage(data, nil, nil)
+	ta := faketime.NewTimeAdvance(fakeTime, 1*time.Second)
+	bm := s.newTestContentManagerWithTweaks(t, st, &contentManagerTestTweaks{
+		ManagerOptions: ManagerOptions{
+			TimeNow:      ta.NowFunc(),
+			FlushTimeout: time.Minute,
+		},
+	})
+
+	writeContentAndVerify(ctx, t, bm, seededRandomData(1, 100))
+	writeContentAndVerify(ctx, t, bm, seededRandomData(2, 100))
+	verifyActiveIndexBlobCount(ctx, t, bm, 0)
+
+	// next write after the timeout flushes pending pack and index.
+	ta.Advance(2 * time.Minute)
+	writeContentAndVerify(ctx, t, bm, seededRandomData(3, 100))
+	verifyActiveIndexBlobCount(ctx, t, bm, 1)
+}
+
+func (s *contentManagerSuite) TestContentManagerParallelFetches(t *testing.T) {
+	ctx := testlogging.Context(t)
+	data := blobtesting.DataMap{}
+	st := blobtesting.NewMapStorage(data, nil, nil)
+	bm := s.newTestContentManager(t, st)
+
+	for i := 0; i < 10; i++ {
+		writeContentAndVerify(ctx, t, bm, seededRandomData(i, 100))
+		require.NoError(t, bm.Flush(ctx))
+	}
+
+	for _, parallel := range []int{1, 3} {
+		cst := newConcurrencyTrackingStorage(st, parallel)
+
+		s.newTestContentManagerWithTweaks(t, cst, &contentManagerTestTweaks{
+			ManagerOptions: ManagerOptions{
+				ParallelFetches: parallel,
+			},
+		})
+
+		require.LessOrEqual(t, cst.maxConcurrent.Load(), int32(parallel))
+		require.True(t, cst.barrierReached(), "expected %v concurrent fetches", parallel)
+	}
+}
+
+// concurrencyTrackingStorage records the maximum number of concurrent GetBlob() calls.
+// Each GetBlob() call is held until the expected number of calls are in flight at the same time (or a timeout
+// elapses), which makes the overlap of concurrent calls independent of timing.
+type concurrencyTrackingStorage struct {
+	blob.Storage
+
+	current       atomic.Int32
+	maxConcurrent atomic.Int32
+
+	expected    int32
+	barrier     chan struct{}
+	barrierOnce sync.Once
+}
+
+func newConcurrencyTrackingStorage(st blob.Storage, expected int) *concurrencyTrackingStorage {
+	return &concurrencyTrackingStorage{
+		Storage:  st,
+		expected: int32(expected),
+		barrier:  make(chan struct{}),
+	}
+}
+
+func (s *concurrencyTrackingStorage) barrierReached() bool {
+	select {
+	case <-s.barrier:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *concurrencyTrackingStorage) GetBlob(ctx context.Context, id blob.ID, offset, length int64, output blob.OutputBuffer) error {
+	n := s.current.Add(1)
+	defer s.current.Add(-1)
+
+	for {
+		m := s.maxConcurrent.Load()
+		if n <= m || s.maxConcurrent.CompareAndSwap(m, n) {
+			break
+		}
+	}
+
+	if n >= s.expected {
+		s.barrierOnce.Do(func() { close(s.barrier) })
+	}
+
+	select {
+	case <-s.barrier:
+	case <-time.After(5 * time.Second):
+	}
+
+	//nolint:wrapcheck
+	return s.Storage.GetBlob(ctx, id, offset, length, output)
+}
+
 func (s *contentManagerSuite) TestContentManagerWriteMultiple(t *testing.T) {
 	ctx := testlogging.Context(t)
 	data := blobtesting.DataMap{}
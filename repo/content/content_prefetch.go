@@ -103,7 +103,7 @@ type work struct {
 		}
 	}()
 
-	for i := 0; i < parallelFetches; i++ {
+	for i := 0; i < bm.parallelFetches; i++ {
 		wg.Add(1)
 
 		go func() {
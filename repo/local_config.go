@@ -38,6 +38,9 @@ type ClientOptions struct {
 
 	FormatBlobCacheDuration time.Duration `json:"formatBlobCacheDuration,omitempty"`
 
+	// ParallelFetches is the number of concurrent reads used when loading indexes, 0 means default.
+	ParallelFetches int `json:"parallelFetches,omitempty"`
+
 	Throttling *throttling.Limits `json:"throttlingLimits,omitempty"`
 }
 
@@ -71,6 +71,9 @@ type Options struct {
 	DoNotWaitForUpgrade bool                       // Disable the exponential forever backoff on an upgrade lock.
 	BeforeFlush         []RepositoryWriterCallback // list of callbacks to invoke before every flush
 
+	FlushTimeout           time.Duration // Time after which pending writes are automatically flushed, 0 == default
+	MaxPendingIndexEntries int           // Number of pending index entries that triggers automatic flush, 0 == unlimited
+
 	OnFatalError func(err error) // function to invoke when repository encounters a fatal error, usually invokes os.Exit
 
 	// test-only flags
@@ -240,6 +243,9 @@ func openWithConfig(ctx context.Context, st blob.Storage, cliOpts ClientOptions,
 		TimeNow:                defaultTime(options.TimeNowFunc),
 		DisableInternalLog:     options.DisableInternalLog,
 		PermissiveCacheLoading: cliOpts.PermissiveCacheLoading,
+		ParallelFetches:        cliOpts.ParallelFetches,
+		FlushTimeout:           options.FlushTimeout,
+		MaxPendingIndexEntries: options.MaxPendingIndexEntries,
 	}
 
 	mr := metrics.NewRegistry()
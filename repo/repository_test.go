@@ -3,6 +3,7 @@
 import (
 	"bytes"
 	"context"
+	cryptorand "crypto/rand"
 	"io"
 	"math/rand"
 	"runtime/debug"
@@ -16,6 +17,7 @@
 
 	"github.com/kopia/kopia/internal/cache"
 	"github.com/kopia/kopia/internal/epoch"
+	"github.com/kopia/kopia/internal/faketime"
 	"github.com/kopia/kopia/internal/gather"
 	"github.com/kopia/kopia/internal/metricid"
 	"github.com/kopia/kopia/internal/repotesting"
@@ -767,6 +769,70 @@ func (s *formatSpecificTestSuite) TestChangePassword(t *testing.T) {
 	}
 }
 
+func TestFlushTimeoutOption(t *testing.T) {
+	ta := faketime.NewTimeAdvance(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Second)
+
+	ctx, env := repotesting.NewEnvironment(t, repotesting.FormatNotImportant, repotesting.Options{
+		OpenOptions: func(o *repo.Options) {
+			o.TimeNowFunc = ta.NowFunc()
+			o.FlushTimeout = time.Minute
+		},
+	})
+
+	cm := env.RepositoryWriter.ContentManager()
+	initialIndexBlobs := mustGetIndexBlobCount(ctx, t, cm)
+
+	_, err := cm.WriteContent(ctx, gather.FromSlice([]byte{1, 2, 3}), "", content.NoCompression)
+	require.NoError(t, err)
+	require.Equal(t, initialIndexBlobs, mustGetIndexBlobCount(ctx, t, cm))
+
+	// first write after the flush timeout triggers automatic flush.
+	ta.Advance(2 * time.Minute)
+
+	_, err = cm.WriteContent(ctx, gather.FromSlice([]byte{4, 5, 6}), "", content.NoCompression)
+	require.NoError(t, err)
+	require.Greater(t, mustGetIndexBlobCount(ctx, t, cm), initialIndexBlobs)
+}
+
+func TestMaxPendingIndexEntriesOption(t *testing.T) {
+	const packSize = 10 << 20
+
+	ctx, env := repotesting.NewEnvironment(t, repotesting.FormatNotImportant, repotesting.Options{
+		NewRepositoryOptions: func(nro *repo.NewRepositoryOptions) {
+			nro.BlockFormat.MaxPackSize = packSize
+		},
+		OpenOptions: func(o *repo.Options) {
+			o.MaxPendingIndexEntries = 1
+		},
+	})
+
+	cm := env.RepositoryWriter.ContentManager()
+	initialIndexBlobs := mustGetIndexBlobCount(ctx, t, cm)
+
+	// writing more than a full pack causes index entries to be pending, which triggers automatic flush
+	// on the following write.
+	b := make([]byte, 1<<20)
+
+	for i := 0; i < 12; i++ {
+		_, err := cryptorand.Read(b)
+		require.NoError(t, err)
+
+		_, err = cm.WriteContent(ctx, gather.FromSlice(b), "", content.NoCompression)
+		require.NoError(t, err)
+	}
+
+	require.Greater(t, mustGetIndexBlobCount(ctx, t, cm), initialIndexBlobs)
+}
+
+func mustGetIndexBlobCount(ctx context.Context, t *testing.T, cm *content.WriteManager) int {
+	t.Helper()
+
+	ibm, err := cm.IndexBlobs(ctx, false)
+	require.NoError(t, err)
+
+	return len(ibm)
+}
+
 func TestMetrics_CompressibleData(t *testing.T) {
 	ctx, env := repotesting.NewEnvironment(t, repotesting.FormatNotImportant)
 	_ = ctx
@@ -11,6 +11,8 @@ type UploadPolicy struct {
 	MaxParallelSnapshots    *OptionalInt   `json:"maxParallelSnapshots,omitempty"`
 	MaxParallelFileReads    *OptionalInt   `json:"maxParallelFileReads,omitempty"`
 	ParallelUploadAboveSize *OptionalInt64 `json:"parallelUploadAboveSize,omitempty"`
+	DetectModifiedFiles     *OptionalBool  `json:"detectModifiedFiles,omitempty"`
+	ModifiedFileRetries     *OptionalInt   `json:"modifiedFileRetries,omitempty"`
 }
 
 // UploadPolicyDefinition specifies which policy definition provided the value of a particular field.
@@ -18,6 +20,8 @@ type UploadPolicyDefinition struct {
 	MaxParallelSnapshots    snapshot.SourceInfo `json:"maxParallelSnapshots,omitempty"`
 	MaxParallelFileReads    snapshot.SourceInfo `json:"maxParallelFileReads,omitempty"`
 	ParallelUploadAboveSize snapshot.SourceInfo `json:"parallelUploadAboveSize,omitempty"`
+	DetectModifiedFiles     snapshot.SourceInfo `json:"detectModifiedFiles,omitempty"`
+	ModifiedFileRetries     snapshot.SourceInfo `json:"modifiedFileRetries,omitempty"`
 }
 
 // Merge applies default values from the provided policy.
@@ -25,6 +29,8 @@ func (p *UploadPolicy) Merge(src UploadPolicy, def *UploadPolicyDefinition, si s
 	mergeOptionalInt(&p.MaxParallelSnapshots, src.MaxParallelSnapshots, &def.MaxParallelSnapshots, si)
 	mergeOptionalInt(&p.MaxParallelFileReads, src.MaxParallelFileReads, &def.MaxParallelFileReads, si)
 	mergeOptionalInt64(&p.ParallelUploadAboveSize, src.ParallelUploadAboveSize, &def.ParallelUploadAboveSize, si)
+	mergeOptionalBool(&p.DetectModifiedFiles, src.DetectModifiedFiles, &def.DetectModifiedFiles, si)
+	mergeOptionalInt(&p.ModifiedFileRetries, src.ModifiedFileRetries, &def.ModifiedFileRetries, si)
 }
 
 // ValidateUploadPolicy returns an error if manual field is set along with Upload fields.
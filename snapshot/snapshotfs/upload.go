@@ -22,6 +22,7 @@
 
 	"github.com/kopia/kopia/fs"
 	"github.com/kopia/kopia/fs/ignorefs"
+	"github.com/kopia/kopia/fs/localfs"
 	"github.com/kopia/kopia/internal/iocopy"
 	"github.com/kopia/kopia/internal/timetrack"
 	"github.com/kopia/kopia/internal/workshare"
@@ -55,6 +56,10 @@
 
 var errCanceled = errors.New("canceled")
 
+// ErrFileModifiedDuringUpload is returned when a file was modified while it was being snapshotted
+// and DetectModifiedFiles policy is enabled.
+var ErrFileModifiedDuringUpload = errors.New("file modified during upload")
+
 // reasons why a snapshot is incomplete.
 const (
 	IncompleteReasonCheckpoint   = "checkpoint"
@@ -160,6 +165,130 @@ func (u *Uploader) uploadFileInternal(ctx context.Context, parentCheckpointRegis
 		}
 	}
 
+	if !pol.UploadPolicy.DetectModifiedFiles.OrDefault(false) {
+		return u.uploadFileContents(ctx, parentCheckpointRegistry, f, pol)
+	}
+
+	return u.uploadFileDetectingModifications(ctx, parentCheckpointRegistry, relativePath, f, pol)
+}
+
+// uploadFileDetectingModifications uploads the provided file and then compares its metadata
+// against the current state of the file on the local filesystem, retrying the upload
+// if the file was modified while it was being read.
+func (u *Uploader) uploadFileDetectingModifications(ctx context.Context, parentCheckpointRegistry *checkpointRegistry, relativePath string, f fs.File, pol *policy.Policy) (_ *snapshot.DirEntry, ret error) {
+	retries := pol.UploadPolicy.ModifiedFileRetries.OrDefault(0)
+
+	// entry re-read from the filesystem on retry, owned by this function.
+	var reread fs.File
+
+	// uploaded entry already included in statistics, which must be undone unless it is returned.
+	var counted *snapshot.DirEntry
+
+	defer func() {
+		if ret != nil && counted != nil {
+			u.discardUploadedFileStats(counted)
+		}
+
+		if reread != nil {
+			reread.Close()
+		}
+	}()
+
+	for attempt := 0; ; attempt++ {
+		de, err := u.uploadFileContents(ctx, parentCheckpointRegistry, f, pol)
+		if err != nil {
+			return nil, err
+		}
+
+		counted = de
+
+		current, modified, err := modifiedSinceListing(f)
+		if err != nil {
+			return nil, errors.Wrap(err, relativePath)
+		}
+
+		if !modified {
+			return de, nil
+		}
+
+		if reread != nil {
+			reread.Close()
+		}
+
+		reread = current
+
+		if attempt >= retries {
+			return nil, errors.Wrapf(ErrFileModifiedDuringUpload, "%v", relativePath)
+		}
+
+		uploadLog(ctx).Debugw("file modified during upload, retrying", "path", relativePath, "attempt", attempt+1)
+
+		// the file will be uploaded again.
+		u.discardUploadedFileStats(de)
+		counted = nil
+
+		f = current
+	}
+}
+
+// discardUploadedFileStats reverts statistics updated by uploadFileContents for an upload that was discarded.
+func (u *Uploader) discardUploadedFileStats(de *snapshot.DirEntry) {
+	atomic.AddInt32(&u.stats.TotalFileCount, -1)
+	atomic.AddInt64(&u.stats.TotalFileSize, -de.FileSize)
+}
+
+// modifiedSinceListing determines whether the local file has a different size or modification time
+// than the provided entry (inode numbers are not compared). A file that was deleted or replaced by
+// another entry type results in ErrFileModifiedDuringUpload. Entries not backed by the local filesystem
+// are never considered modified.
+func modifiedSinceListing(f fs.File) (current fs.File, modified bool, err error) {
+	localPath := f.LocalFilesystemPath()
+	if localPath == "" {
+		return f, false, nil
+	}
+
+	e, err := localfs.NewEntry(localPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, errors.Wrap(ErrFileModifiedDuringUpload, "file was deleted")
+	}
+
+	if err != nil {
+		return nil, false, errors.Wrap(err, "unable to stat file after upload")
+	}
+
+	current, ok := e.(fs.File)
+	if !ok {
+		e.Close()
+
+		return nil, false, errors.Wrap(ErrFileModifiedDuringUpload, "no longer a file")
+	}
+
+	if current.Size() == f.Size() && current.ModTime().Equal(f.ModTime()) {
+		current.Close()
+
+		return f, false, nil
+	}
+
+	return current, true, nil
+}
+
+// uploadFileContents uploads the contents of the provided file and updates upload statistics.
+func (u *Uploader) uploadFileContents(ctx context.Context, parentCheckpointRegistry *checkpointRegistry, f fs.File, pol *policy.Policy) (*snapshot.DirEntry, error) {
+	de, err := u.uploadFileParts(ctx, parentCheckpointRegistry, f, pol)
+	if err != nil {
+		return nil, err
+	}
+
+	// the file is counted once, regardless of the number of parts it was uploaded in.
+	atomic.AddInt32(&u.stats.TotalFileCount, 1)
+	atomic.AddInt64(&u.stats.TotalFileSize, de.FileSize)
+
+	return de, nil
+}
+
+// uploadFileParts uploads the contents of the provided file, splitting it into parts uploaded
+// in parallel when it is large enough.
+func (u *Uploader) uploadFileParts(ctx context.Context, parentCheckpointRegistry *checkpointRegistry, f fs.File, pol *policy.Policy) (*snapshot.DirEntry, error) {
 	comp := pol.CompressionPolicy.CompressorForFile(f)
 
 	chunkSize := pol.UploadPolicy.ParallelUploadAboveSize.OrDefault(-1)
@@ -292,9 +421,6 @@ func (u *Uploader) uploadFileData(ctx context.Context, parentCheckpointRegistry
 
 	de.FileSize = written
 
-	atomic.AddInt32(&u.stats.TotalFileCount, 1)
-	atomic.AddInt64(&u.stats.TotalFileSize, de.FileSize)
-
 	return de, nil
 }
 
@@ -35,6 +35,7 @@
 	"github.com/kopia/kopia/internal/repotesting"
 	"github.com/kopia/kopia/internal/testlogging"
 	"github.com/kopia/kopia/internal/testutil"
+	"github.com/kopia/kopia/internal/workshare"
 	"github.com/kopia/kopia/repo"
 	"github.com/kopia/kopia/repo/blob/filesystem"
 	bloblogging "github.com/kopia/kopia/repo/blob/logging"
@@ -1664,3 +1665,106 @@ func verifyLogDetails(t *testing.T, desc string, wantDetailKeys []string, keysAn
 	sort.Strings(wantDetailKeys)
 	require.Equal(t, wantDetailKeys, gotDetailKeys, "invalid details for "+desc)
 }
+
+// modifyOnOpenFile is a fs.File which modifies the underlying local file after it is opened,
+// simulating a concurrent writer.
+type modifyOnOpenFile struct {
+	fs.File
+	t      *testing.T
+	modify func(t *testing.T, path string, listed fs.Entry)
+}
+
+func (f *modifyOnOpenFile) Open(ctx context.Context) (fs.Reader, error) {
+	r, err := f.File.Open(ctx)
+	if err == nil {
+		f.modify(f.t, f.LocalFilesystemPath(), f.File)
+	}
+
+	return r, err
+}
+
+func TestUpload_DetectModifiedFiles(t *testing.T) {
+	trueValue := policy.OptionalBool(true)
+	oneValue := policy.OptionalInt(1)
+	smallParts := policy.OptionalInt64(5)
+
+	rewrite := func(t *testing.T, path string, listed fs.Entry) {
+		t.Helper()
+
+		require.NoError(t, os.WriteFile(path, []byte("modified contents"), 0o600))
+		require.NoError(t, os.Chtimes(path, listed.ModTime(), listed.ModTime().Add(time.Hour)))
+	}
+
+	remove := func(t *testing.T, path string, _ fs.Entry) {
+		t.Helper()
+
+		require.NoError(t, os.Remove(path))
+	}
+
+	replaceWithDir := func(t *testing.T, path string, _ fs.Entry) {
+		t.Helper()
+
+		require.NoError(t, os.Remove(path))
+		require.NoError(t, os.Mkdir(path, 0o700))
+	}
+
+	cases := []struct {
+		desc      string
+		modify    func(t *testing.T, path string, listed fs.Entry)
+		detect    *policy.OptionalBool
+		retries   *policy.OptionalInt
+		partSize  *policy.OptionalInt64
+		wantErr   error
+		wantSize  int64
+		wantCount int32
+	}{
+		{desc: "detection disabled", modify: rewrite, wantSize: int64(len("modified contents")), wantCount: 1},
+		{desc: "detection enabled, no retries", modify: rewrite, detect: &trueValue, wantErr: ErrFileModifiedDuringUpload},
+		{desc: "detection enabled, with retries", modify: rewrite, detect: &trueValue, retries: &oneValue, wantSize: int64(len("modified contents")), wantCount: 1},
+		{desc: "detection enabled, with retries, parallel parts", modify: rewrite, detect: &trueValue, retries: &oneValue, partSize: &smallParts, wantSize: int64(len("modified contents")), wantCount: 1},
+		{desc: "file deleted", modify: remove, detect: &trueValue, retries: &oneValue, wantErr: ErrFileModifiedDuringUpload},
+		{desc: "file replaced by directory", modify: replaceWithDir, detect: &trueValue, retries: &oneValue, wantErr: ErrFileModifiedDuringUpload},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := testlogging.Context(t)
+			th := newUploadTestHarness(ctx, t)
+
+			defer th.cleanup()
+
+			td := testutil.TempDirectory(t)
+			fname := filepath.Join(td, "file")
+
+			require.NoError(t, os.WriteFile(fname, []byte("original"), 0o600))
+
+			e, err := localfs.NewEntry(fname)
+			require.NoError(t, err)
+
+			pol := *policy.DefaultPolicy
+			pol.UploadPolicy.DetectModifiedFiles = tc.detect
+			pol.UploadPolicy.ModifiedFileRetries = tc.retries
+
+			if tc.partSize != nil {
+				pol.UploadPolicy.ParallelUploadAboveSize = tc.partSize
+			}
+
+			u := NewUploader(th.repo)
+			u.stats = &snapshot.Stats{}
+			u.workerPool = workshare.NewPool[*uploadWorkItem](2)
+
+			defer u.workerPool.Close()
+
+			de, err := u.uploadFileInternal(ctx, &checkpointRegistry{}, "file", &modifyOnOpenFile{e.(fs.File), t, tc.modify}, &pol)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantSize, de.FileSize)
+			}
+
+			require.Equal(t, tc.wantCount, u.stats.TotalFileCount)
+			require.Equal(t, tc.wantSize, u.stats.TotalFileSize)
+		})
+	}
+}